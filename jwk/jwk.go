@@ -0,0 +1,121 @@
+// Package jwk converts between this module's Ed25519 key material and
+// RFC 7517 JSON Web Keys, using the OKP key type with crv "Ed25519" as
+// supported by go-jose v2.1+. It also exposes a libtrust-style fingerprint
+// so nodes and wallets can display a stable, human-comparable key ID
+// alongside the Blake2b-based Ed25519Address.
+package jwk
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base32"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	iota "github.com/maxbull/iota.go"
+	jose "gopkg.in/square/go-jose.v2"
+)
+
+// fingerprintGroups is the number of colon-separated groups in a fingerprint.
+const fingerprintGroups = 12
+
+// fingerprintGroupSize is the number of base32 characters per group.
+const fingerprintGroupSize = 4
+
+// FingerprintFromEd25519PubKey computes a libtrust-style fingerprint for pub:
+// the SHA-256 digest of its DER (PKIX) encoding, truncated to the first 240
+// bits, base32 encoded and split into 12 colon-separated groups of 4
+// characters (e.g. "PYYO:TEWU:V7JH:...").
+func FingerprintFromEd25519PubKey(pub []byte) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(ed25519.PublicKey(pub))
+	if err != nil {
+		return "", fmt.Errorf("unable to DER encode Ed25519 public key: %w", err)
+	}
+	digest := sha256.Sum256(der)
+	encoded := base32.StdEncoding.EncodeToString(digest[:fingerprintGroups*fingerprintGroupSize*5/8])
+
+	groups := make([]string, 0, fingerprintGroups)
+	for i := 0; i < len(encoded); i += fingerprintGroupSize {
+		groups = append(groups, encoded[i:i+fingerprintGroupSize])
+	}
+	return strings.Join(groups, ":"), nil
+}
+
+// Ed25519PublicKeyToJWK encodes pub as an RFC 7517 OKP JSON Web Key, keyed by
+// its libtrust fingerprint (see FingerprintFromEd25519PubKey).
+func Ed25519PublicKeyToJWK(pub ed25519.PublicKey) ([]byte, error) {
+	kid, err := FingerprintFromEd25519PubKey(pub)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(jose.JSONWebKey{Key: pub, KeyID: kid, Algorithm: "EdDSA", Use: "sig"})
+}
+
+// Ed25519PrivateKeyToJWK encodes priv as an RFC 7517 OKP JSON Web Key, keyed
+// by the fingerprint of its corresponding public key.
+func Ed25519PrivateKeyToJWK(priv ed25519.PrivateKey) ([]byte, error) {
+	kid, err := FingerprintFromEd25519PubKey(priv.Public().(ed25519.PublicKey))
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(jose.JSONWebKey{Key: priv, KeyID: kid, Algorithm: "EdDSA", Use: "sig"})
+}
+
+// Ed25519FromJWK decodes an RFC 7517 OKP JWK previously produced by
+// Ed25519PublicKeyToJWK or Ed25519PrivateKeyToJWK, returning the go-jose key
+// wrapper with either an ed25519.PublicKey or ed25519.PrivateKey underneath.
+func Ed25519FromJWK(data []byte) (jose.JSONWebKey, error) {
+	var key jose.JSONWebKey
+	if err := json.Unmarshal(data, &key); err != nil {
+		return jose.JSONWebKey{}, fmt.Errorf("unable to decode JWK: %w", err)
+	}
+	switch key.Key.(type) {
+	case ed25519.PublicKey, ed25519.PrivateKey:
+	default:
+		return jose.JSONWebKey{}, fmt.Errorf("JWK does not contain an Ed25519 key")
+	}
+	return key, nil
+}
+
+// Ed25519AddressFromJWK decodes an RFC 7517 OKP JWK carrying an Ed25519
+// public key and derives the corresponding Ed25519Address.
+func Ed25519AddressFromJWK(data []byte) (*iota.Ed25519Address, ed25519.PublicKey, error) {
+	key, err := Ed25519FromJWK(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	pub, ok := key.Key.(ed25519.PublicKey)
+	if !ok {
+		return nil, nil, fmt.Errorf("JWK does not contain an Ed25519 public key")
+	}
+	addr := iota.AddressFromEd25519PubKey(pub)
+	return &addr, pub, nil
+}
+
+// JWKSet builds an RFC 7517 JWK Set (a "keys" JSON array) out of one or more
+// Ed25519 public keys.
+type JWKSet struct {
+	keys []jose.JSONWebKey
+}
+
+// NewJWKSet creates an empty JWKSet.
+func NewJWKSet() *JWKSet {
+	return &JWKSet{}
+}
+
+// AddEd25519PublicKey adds pub to the set, keyed by its libtrust fingerprint.
+func (s *JWKSet) AddEd25519PublicKey(pub ed25519.PublicKey) error {
+	kid, err := FingerprintFromEd25519PubKey(pub)
+	if err != nil {
+		return err
+	}
+	s.keys = append(s.keys, jose.JSONWebKey{Key: pub, KeyID: kid, Algorithm: "EdDSA", Use: "sig"})
+	return nil
+}
+
+// MarshalJSON emits the set as {"keys": [...]}.
+func (s *JWKSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jose.JSONWebKeySet{Keys: s.keys})
+}
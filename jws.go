@@ -0,0 +1,227 @@
+package iota
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// Returned when data parsed as a JWS is malformed: it does not have three
+	// segments, its jwk header parameter is missing or not an Ed25519 OKP key,
+	// b64 is not listed in crit, or its signature has the wrong length.
+	ErrJWSMalformed = errors.New("malformed JWS")
+	// Returned when a JWS protected header does not declare alg "EdDSA".
+	ErrJWSUnsupportedAlg = errors.New("unsupported JWS alg: only EdDSA is supported")
+	// Returned when a JWS carries a detached payload but none was supplied out-of-band.
+	ErrJWSDetachedPayloadMissing = errors.New("JWS has a detached payload but none was supplied")
+	// Returned when a JWS signature does not verify against its signing input.
+	ErrJWSSignatureInvalid = errors.New("JWS signature is invalid")
+)
+
+// jwsHeader is the protected header of an iota Ed25519 JWS. B64 and Crit are
+// only populated for RFC 7797 unencoded/detached payload JWS.
+type jwsHeader struct {
+	Alg  string   `json:"alg"`
+	Typ  string   `json:"typ"`
+	B64  *bool    `json:"b64,omitempty"`
+	Crit []string `json:"crit,omitempty"`
+	JWK  *okpJWK  `json:"jwk"`
+}
+
+// okpJWK is the minimal RFC 8037 OKP JWK representation needed to embed and
+// recover an Ed25519 public key from a JWS protected header.
+type okpJWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+}
+
+func newOKPJWK(pubKey []byte) *okpJWK {
+	return &okpJWK{Kty: "OKP", Crv: "Ed25519", X: base64.RawURLEncoding.EncodeToString(pubKey)}
+}
+
+func (j *okpJWK) pubKey() (ed25519.PublicKey, error) {
+	if j == nil {
+		return nil, fmt.Errorf("%w: missing jwk header parameter", ErrJWSMalformed)
+	}
+	if j.Kty != "OKP" || j.Crv != "Ed25519" {
+		return nil, fmt.Errorf("%w: jwk header parameter is not an Ed25519 OKP key", ErrJWSMalformed)
+	}
+	pubKeyBytes, err := base64.RawURLEncoding.DecodeString(j.X)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode jwk.x: %w", err)
+	}
+	if len(pubKeyBytes) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("%w: jwk.x has an invalid length", ErrJWSMalformed)
+	}
+	return pubKeyBytes, nil
+}
+
+// MarshalJWS signs payload with signer and encodes the result as a JWS in
+// compact serialization: base64url(protected) + "." + base64url(payload) +
+// "." + base64url(signature). Per RFC 7515 the signature covers the JWS
+// Signing Input (protected header || "." || payload), not the raw payload,
+// so payload is signed at marshal time rather than reusing a previously
+// computed Ed25519Signature. The protected header embeds the signing public
+// key as an RFC 8037 OKP JWK, so verifiers that already speak JOSE (go-jose,
+// jose4j, python-jose) can verify the essence without linking against this
+// module.
+func MarshalJWS(signer Signer, payload []byte) ([]byte, error) {
+	pubKey, headerJWK, err := signerPubKeyJWK(signer)
+	if err != nil {
+		return nil, err
+	}
+
+	headerBytes, err := json.Marshal(jwsHeader{Alg: "EdDSA", Typ: "iota-essence", JWK: headerJWK})
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode JWS protected header: %w", err)
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerBytes) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	signedPubKey, sig, err := signer.Sign([]byte(signingInput))
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign JWS signing input: %w", err)
+	}
+	if signedPubKey != pubKey {
+		return nil, fmt.Errorf("signer returned inconsistent public keys across Sign calls")
+	}
+
+	return []byte(signingInput + "." + base64.RawURLEncoding.EncodeToString(sig[:])), nil
+}
+
+// MarshalDetachedJWS signs payload with signer and encodes the result as an
+// RFC 7797 JWS with an unencoded, detached payload: the protected header
+// carries "b64":false and "crit":["b64"], the EdDSA signature covers the raw
+// (non-base64url-encoded) payload bytes, and the compact serialization's
+// payload segment is left empty so payload must be supplied out-of-band via
+// ParseDetachedJWS. This is what tangle explorers and audit tools want when
+// the signed essence is large.
+func MarshalDetachedJWS(signer Signer, payload []byte) ([]byte, error) {
+	pubKey, headerJWK, err := signerPubKeyJWK(signer)
+	if err != nil {
+		return nil, err
+	}
+
+	b64 := false
+	headerBytes, err := json.Marshal(jwsHeader{Alg: "EdDSA", Typ: "iota-essence", B64: &b64, Crit: []string{"b64"}, JWK: headerJWK})
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode JWS protected header: %w", err)
+	}
+
+	encodedHeader := base64.RawURLEncoding.EncodeToString(headerBytes)
+	signingInput := append([]byte(encodedHeader+"."), payload...)
+	signedPubKey, sig, err := signer.Sign(signingInput)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign JWS signing input: %w", err)
+	}
+	if signedPubKey != pubKey {
+		return nil, fmt.Errorf("signer returned inconsistent public keys across Sign calls")
+	}
+
+	return []byte(encodedHeader + ".." + base64.RawURLEncoding.EncodeToString(sig[:])), nil
+}
+
+// signerPubKeyJWK signs an empty message purely to learn signer's public
+// key (the key must be known before the protected header, which embeds it,
+// can be built) and returns it alongside its OKP JWK encoding.
+func signerPubKeyJWK(signer Signer) (pubKey [ed25519.PublicKeySize]byte, jwk *okpJWK, err error) {
+	pubKey, _, err = signer.Sign([]byte{})
+	if err != nil {
+		return pubKey, nil, fmt.Errorf("unable to determine signer public key: %w", err)
+	}
+	return pubKey, newOKPJWK(pubKey[:]), nil
+}
+
+// ParseJWS decodes and verifies a non-detached compact-serialization JWS
+// produced by MarshalJWS, returning the embedded payload and the
+// Ed25519Signature that produced it. For a JWS with an RFC 7797 detached
+// payload, use ParseDetachedJWS instead.
+func ParseJWS(data []byte) (payload []byte, sig *Ed25519Signature, err error) {
+	return parseJWS(data, nil)
+}
+
+// ParseDetachedJWS decodes and verifies a compact-serialization JWS produced
+// by MarshalDetachedJWS, whose payload segment is empty: payload must be
+// supplied out-of-band by the caller.
+func ParseDetachedJWS(data []byte, payload []byte) (sig *Ed25519Signature, err error) {
+	_, sig, err = parseJWS(data, payload)
+	return sig, err
+}
+
+func parseJWS(data []byte, detachedPayload []byte) (payload []byte, sig *Ed25519Signature, err error) {
+	segments := strings.Split(string(data), ".")
+	if len(segments) != 3 {
+		return nil, nil, fmt.Errorf("%w: expected three dot-separated segments, got %d", ErrJWSMalformed, len(segments))
+	}
+
+	headerBytes, err := base64.RawURLEncoding.DecodeString(segments[0])
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to decode JWS protected header: %w", err)
+	}
+	var header jwsHeader
+	if err := json.Unmarshal(headerBytes, &header); err != nil {
+		return nil, nil, fmt.Errorf("unable to decode JWS protected header: %w", err)
+	}
+	if header.Alg != "EdDSA" {
+		return nil, nil, fmt.Errorf("%w: got %q", ErrJWSUnsupportedAlg, header.Alg)
+	}
+	pubKey, err := header.JWK.pubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	unencodedPayload := header.B64 != nil && !*header.B64
+	if unencodedPayload && !containsString(header.Crit, "b64") {
+		return nil, nil, fmt.Errorf("%w: b64 header parameter must be listed in crit", ErrJWSMalformed)
+	}
+
+	var signingInput []byte
+	switch {
+	case unencodedPayload:
+		if segments[1] == "" {
+			if detachedPayload == nil {
+				return nil, nil, ErrJWSDetachedPayloadMissing
+			}
+			payload = detachedPayload
+		} else {
+			payload = []byte(segments[1])
+		}
+		signingInput = append([]byte(segments[0]+"."), payload...)
+	default:
+		payload, err = base64.RawURLEncoding.DecodeString(segments[1])
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to decode JWS payload: %w", err)
+		}
+		signingInput = []byte(segments[0] + "." + segments[1])
+	}
+
+	sigBytes, err := base64.RawURLEncoding.DecodeString(segments[2])
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to decode JWS signature: %w", err)
+	}
+	if len(sigBytes) != ed25519.SignatureSize {
+		return nil, nil, fmt.Errorf("%w: signature has an invalid length", ErrJWSMalformed)
+	}
+
+	if !ed25519.Verify(pubKey, signingInput, sigBytes) {
+		return nil, nil, ErrJWSSignatureInvalid
+	}
+
+	sig = &Ed25519Signature{}
+	copy(sig.PublicKey[:], pubKey)
+	copy(sig.Signature[:], sigBytes)
+	return payload, sig, nil
+}
+
+func containsString(haystack []string, needle string) bool {
+	for _, s := range haystack {
+		if s == needle {
+			return true
+		}
+	}
+	return false
+}
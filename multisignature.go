@@ -0,0 +1,213 @@
+package iota
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// multiSignatureCountSize is the size in bytes of a MultiSignature's
+// serialized member-signature count (a uint16).
+const multiSignatureCountSize = 2
+
+const (
+	// Denotes a multi Ed25519 signature consisting of several member signatures.
+	SignatureMulti SignatureType = 2
+)
+
+var (
+	// Returned when a MultiEd25519Address is constructed with an invalid threshold.
+	ErrMultiEd25519InvalidThreshold = errors.New("threshold must be greater than zero and not exceed the number of member keys (MultiEd25519)")
+	// Returned when a MultiEd25519Address and its member public keys do not correspond to each other.
+	ErrMultiEd25519PubKeysAndAddrMismatch = errors.New("public keys and threshold do not correspond to the given address (MultiEd25519)")
+	// Returned when a MultiSignature does not carry enough valid, unique member signatures to satisfy its threshold.
+	ErrMultiEd25519ThresholdNotReached = errors.New("not enough valid, unique member signatures to satisfy the threshold (MultiEd25519)")
+)
+
+// MultiEd25519Address is the Blake2b-256 hash of the sorted concatenation of
+// an M-of-N multi-signature wallet's member public keys, followed by a
+// single threshold byte.
+type MultiEd25519Address [32]byte
+
+// NewMultiEd25519Address computes the MultiEd25519Address for the given
+// member public keys and threshold. The order of pubKeys does not matter:
+// keys are sorted before hashing so two callers describing the same
+// membership always derive the same address.
+func NewMultiEd25519Address(threshold byte, pubKeys ...ed25519.PublicKey) (*MultiEd25519Address, error) {
+	if threshold == 0 || int(threshold) > len(pubKeys) {
+		return nil, fmt.Errorf("%w: threshold %d with %d member keys", ErrMultiEd25519InvalidThreshold, threshold, len(pubKeys))
+	}
+
+	sorted := make([]ed25519.PublicKey, len(pubKeys))
+	copy(sorted, pubKeys)
+	sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i], sorted[j]) < 0 })
+
+	h, err := blake2b.New256(nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to hash MultiEd25519Address: %w", err)
+	}
+	for _, pk := range sorted {
+		if _, err := h.Write(pk); err != nil {
+			return nil, fmt.Errorf("unable to hash MultiEd25519Address: %w", err)
+		}
+	}
+	if _, err := h.Write([]byte{threshold}); err != nil {
+		return nil, fmt.Errorf("unable to hash MultiEd25519Address: %w", err)
+	}
+
+	addr := &MultiEd25519Address{}
+	copy(addr[:], h.Sum(nil))
+	return addr, nil
+}
+
+// MultiSignature defines a multi-signature over a single essence, mirroring
+// the JWS "general JSON serialization" idea of one payload with several
+// independent signatures: one per member of an M-of-N wallet. A member who
+// did not sign is represented by an Ed25519Signature with its Signature
+// field left at its zero value.
+type MultiSignature struct {
+	Signatures []*Ed25519Signature
+}
+
+func (s *MultiSignature) Deserialize(data []byte, deSeriMode DeSerializationMode) (int, error) {
+	if deSeriMode.HasMode(DeSeriModePerformValidation) {
+		if err := checkTypeByte(data, SignatureMulti); err != nil {
+			return 0, fmt.Errorf("unable to deserialize multi signature: %w", err)
+		}
+	}
+	data = data[SmallTypeDenotationByteSize:]
+	if err := checkMinByteLength(multiSignatureCountSize, len(data)); err != nil {
+		return 0, fmt.Errorf("invalid multi signature bytes: %w", err)
+	}
+	sigCount := binary.LittleEndian.Uint16(data)
+	data = data[multiSignatureCountSize:]
+
+	bytesRead := SmallTypeDenotationByteSize + multiSignatureCountSize
+	sigs := make([]*Ed25519Signature, sigCount)
+	for i := 0; i < int(sigCount); i++ {
+		sig := &Ed25519Signature{}
+		n, err := sig.Deserialize(data, deSeriMode)
+		if err != nil {
+			return 0, fmt.Errorf("unable to deserialize member signature %d of multi signature: %w", i, err)
+		}
+		sigs[i] = sig
+		data = data[n:]
+		bytesRead += n
+	}
+	s.Signatures = sigs
+	return bytesRead, nil
+}
+
+func (s *MultiSignature) Serialize(deSeriMode DeSerializationMode) ([]byte, error) {
+	var b bytes.Buffer
+	if err := b.WriteByte(SignatureMulti); err != nil {
+		return nil, fmt.Errorf("unable to serialize multi signature type: %w", err)
+	}
+	countBytes := make([]byte, multiSignatureCountSize)
+	binary.LittleEndian.PutUint16(countBytes, uint16(len(s.Signatures)))
+	if _, err := b.Write(countBytes); err != nil {
+		return nil, fmt.Errorf("unable to serialize multi signature count: %w", err)
+	}
+	for i, sig := range s.Signatures {
+		sigBytes, err := sig.Serialize(deSeriMode)
+		if err != nil {
+			return nil, fmt.Errorf("unable to serialize member signature %d of multi signature: %w", i, err)
+		}
+		if _, err := b.Write(sigBytes); err != nil {
+			return nil, fmt.Errorf("unable to serialize member signature %d of multi signature: %w", i, err)
+		}
+	}
+	return b.Bytes(), nil
+}
+
+func (s *MultiSignature) MarshalJSON() ([]byte, error) {
+	jsonSig := &JSONMultiSignature{Type: int(SignatureMulti)}
+	for i, sig := range s.Signatures {
+		sigBytes, err := sig.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode member signature %d of multi signature: %w", i, err)
+		}
+		jsonSig.Signatures = append(jsonSig.Signatures, json.RawMessage(sigBytes))
+	}
+	return json.Marshal(jsonSig)
+}
+
+func (s *MultiSignature) UnmarshalJSON(bytes []byte) error {
+	jsonSig := &JSONMultiSignature{}
+	if err := json.Unmarshal(bytes, jsonSig); err != nil {
+		return err
+	}
+	seri, err := jsonSig.ToSerializable()
+	if err != nil {
+		return err
+	}
+	*s = *seri.(*MultiSignature)
+	return nil
+}
+
+// Valid verifies that, given msg and addr, at least threshold distinct
+// member signatures within s are valid and unique, and that the member
+// public keys carried by s correspond to addr under that same threshold.
+func (s *MultiSignature) Valid(msg []byte, addr *MultiEd25519Address, threshold int) error {
+	pubKeys := make([]ed25519.PublicKey, len(s.Signatures))
+	for i, sig := range s.Signatures {
+		pubKey := make(ed25519.PublicKey, ed25519.PublicKeySize)
+		copy(pubKey, sig.PublicKey[:])
+		pubKeys[i] = pubKey
+	}
+
+	addrFromPubKeys, err := NewMultiEd25519Address(byte(threshold), pubKeys...)
+	if err != nil {
+		return fmt.Errorf("unable to recompute MultiEd25519Address: %w", err)
+	}
+	if !bytes.Equal(addr[:], addrFromPubKeys[:]) {
+		return fmt.Errorf("%w: address %x", ErrMultiEd25519PubKeysAndAddrMismatch, addr[:])
+	}
+
+	var zeroSig [ed25519.SignatureSize]byte
+	seen := make(map[[ed25519.PublicKeySize]byte]struct{}, len(s.Signatures))
+	valid := 0
+	for _, sig := range s.Signatures {
+		if sig.Signature == zeroSig {
+			// member did not sign
+			continue
+		}
+		if _, dup := seen[sig.PublicKey]; dup {
+			continue
+		}
+		if !ed25519.Verify(sig.PublicKey[:], msg, sig.Signature[:]) {
+			continue
+		}
+		seen[sig.PublicKey] = struct{}{}
+		valid++
+	}
+
+	if valid < threshold {
+		return fmt.Errorf("%w: got %d valid signatures, need %d", ErrMultiEd25519ThresholdNotReached, valid, threshold)
+	}
+	return nil
+}
+
+// JSONMultiSignature defines the JSON representation of a MultiSignature.
+type JSONMultiSignature struct {
+	Type       int               `json:"type"`
+	Signatures []json.RawMessage `json:"signatures"`
+}
+
+func (j *JSONMultiSignature) ToSerializable() (Serializable, error) {
+	sigs := make([]*Ed25519Signature, len(j.Signatures))
+	for i, rawSig := range j.Signatures {
+		sig := &Ed25519Signature{}
+		if err := sig.UnmarshalJSON(rawSig); err != nil {
+			return nil, fmt.Errorf("unable to decode member signature %d of multi signature from JSON: %w", i, err)
+		}
+		sigs[i] = sig
+	}
+	return &MultiSignature{Signatures: sigs}, nil
+}
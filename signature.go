@@ -39,34 +39,89 @@ func SignatureSelector(sigType uint32) (Serializable, error) {
 		seri = &WOTSSignature{}
 	case SignatureEd25519:
 		seri = &Ed25519Signature{}
+	case SignatureMulti:
+		seri = &MultiSignature{}
 	default:
 		return nil, fmt.Errorf("%w: type byte %d", ErrUnknownSignatureType, sigType)
 	}
 	return seri, nil
 }
 
-// WOTSSignature defines a WOTS signature.
-type WOTSSignature struct{}
+// WOTSSignature defines a Winternitz One-Time Signature Plus (WOTS+) signature.
+type WOTSSignature struct {
+	// The public seed used when deriving the signer's hash chains.
+	PubSeed [WOTSPublicSeedSize]byte
+	// The revealed hash chain values, one per message or checksum digit (see wotsMessageDigits).
+	Chains [wotsLen][wotsN]byte
+}
 
 func (w *WOTSSignature) Deserialize(data []byte, deSeriMode DeSerializationMode) (int, error) {
 	if deSeriMode.HasMode(DeSeriModePerformValidation) {
+		if err := checkMinByteLength(WOTSSignatureSerializedBytesSize, len(data)); err != nil {
+			return 0, fmt.Errorf("invalid WOTS+ signature bytes: %w", err)
+		}
 		if err := checkTypeByte(data, SignatureWOTS); err != nil {
-			return 0, fmt.Errorf("unable to deserialize WOTS signature: %w", err)
+			return 0, fmt.Errorf("unable to deserialize WOTS+ signature: %w", err)
 		}
 	}
-	return 0, ErrWOTSNotImplemented
+	// skip type byte
+	data = data[SmallTypeDenotationByteSize:]
+	copy(w.PubSeed[:], data[:WOTSPublicSeedSize])
+	data = data[WOTSPublicSeedSize:]
+	for i := 0; i < wotsLen; i++ {
+		copy(w.Chains[i][:], data[i*wotsN:(i+1)*wotsN])
+	}
+	return WOTSSignatureSerializedBytesSize, nil
 }
 
 func (w *WOTSSignature) Serialize(deSeriMode DeSerializationMode) ([]byte, error) {
-	return nil, ErrWOTSNotImplemented
+	b := make([]byte, WOTSSignatureSerializedBytesSize)
+	b[0] = SignatureWOTS
+	copy(b[SmallTypeDenotationByteSize:], w.PubSeed[:])
+	offset := SmallTypeDenotationByteSize + WOTSPublicSeedSize
+	for i, chain := range w.Chains {
+		copy(b[offset+i*wotsN:], chain[:])
+	}
+	return b, nil
 }
 
 func (w *WOTSSignature) MarshalJSON() ([]byte, error) {
-	return nil, ErrWOTSNotImplemented
+	jsonSig := &JSONWOTSSignature{}
+	jsonSig.Type = int(SignatureWOTS)
+	jsonSig.PubSeed = hex.EncodeToString(w.PubSeed[:])
+	jsonSig.Chains = make([]string, wotsLen)
+	for i, chain := range w.Chains {
+		jsonSig.Chains[i] = hex.EncodeToString(chain[:])
+	}
+	return json.Marshal(jsonSig)
+}
+
+func (w *WOTSSignature) UnmarshalJSON(bytes []byte) error {
+	jsonSig := &JSONWOTSSignature{}
+	if err := json.Unmarshal(bytes, jsonSig); err != nil {
+		return err
+	}
+	seri, err := jsonSig.ToSerializable()
+	if err != nil {
+		return err
+	}
+	*w = *seri.(*WOTSSignature)
+	return nil
 }
 
-func (w *WOTSSignature) UnmarshalJSON(i []byte) error {
-	return ErrWOTSNotImplemented
+// Valid verifies whether, given the message and WOTS address, the signature is valid.
+func (w *WOTSSignature) Valid(msg [wotsN]byte, addr *WOTSAddress) error {
+	digits := wotsMessageDigits(msg)
+	var pk [wotsLen][wotsN]byte
+	for i := 0; i < wotsLen; i++ {
+		pk[i] = wotsChain(w.PubSeed, w.Chains[i], uint32(i), int(digits[i]), wotsW-1-int(digits[i]))
+	}
+	pubKey := wotsPublicKeyHash(pk, w.PubSeed)
+	addrFromPubKey := AddressFromWOTSPubKey(pubKey[:])
+	if !bytes.Equal(addr[:], addrFromPubKey[:]) {
+		return fmt.Errorf("%w: address %x, public key %x", ErrWOTSPubKeyAndAddrMismatch, addr[:], pubKey[:])
+	}
+	return nil
 }
 
 // Ed25519Signature defines an Ed25519 signature.
@@ -143,6 +198,8 @@ func JSONSignatureSelector(ty int) (JSONSerializable, error) {
 		obj = &JSONWOTSSignature{}
 	case SignatureEd25519:
 		obj = &JSONEd25519Signature{}
+	case SignatureMulti:
+		obj = &JSONMultiSignature{}
 	default:
 		return nil, fmt.Errorf("unable to decode signature type from JSON: %w", ErrUnknownUnlockBlockType)
 	}
@@ -176,9 +233,29 @@ func (j *JSONEd25519Signature) ToSerializable() (Serializable, error) {
 
 // JSONWOTSSignature defines the json representation of a WOTSSignature.
 type JSONWOTSSignature struct {
-	// TODO: implement
+	Type    int      `json:"type"`
+	PubSeed string   `json:"pubSeed"`
+	Chains  []string `json:"chains"`
 }
 
 func (j *JSONWOTSSignature) ToSerializable() (Serializable, error) {
-	return nil, ErrWOTSNotImplemented
-}
\ No newline at end of file
+	sig := &WOTSSignature{}
+
+	pubSeedBytes, err := hex.DecodeString(j.PubSeed)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode public seed from JSON for WOTS+ signature: %w", err)
+	}
+	copy(sig.PubSeed[:], pubSeedBytes)
+
+	if len(j.Chains) != wotsLen {
+		return nil, fmt.Errorf("unable to decode WOTS+ signature from JSON: expected %d chains, got %d", wotsLen, len(j.Chains))
+	}
+	for i, chainHex := range j.Chains {
+		chainBytes, err := hex.DecodeString(chainHex)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode chain %d from JSON for WOTS+ signature: %w", i, err)
+		}
+		copy(sig.Chains[i][:], chainBytes)
+	}
+	return sig, nil
+}
@@ -0,0 +1,82 @@
+package iota
+
+import (
+	"crypto/ed25519"
+	"fmt"
+)
+
+// Signer produces Ed25519 signatures over arbitrary messages, abstracting
+// away where and how the underlying private key material is held. This
+// allows transaction and unlock-block signing paths to remain agnostic to
+// whether a key lives in process memory, in an HSM, or behind a remote
+// signing service.
+type Signer interface {
+	// Sign returns the public key and signature for msg.
+	Sign(msg []byte) (pubKey [ed25519.PublicKeySize]byte, sig [ed25519.SignatureSize]byte, err error)
+}
+
+// OpaqueSigner is implemented by callers whose private key never leaves a
+// remote boundary, mirroring go-jose's opaque.go pattern: the public key is
+// fetched up front and signing happens against an opaque handle rather than
+// raw key material, so an OpaqueSigner implementation can wrap an HSM, a KMS,
+// a YubiKey, or a remote signing service without ever exposing the private
+// key to this process.
+type OpaqueSigner interface {
+	// PublicKey returns the Ed25519 public key corresponding to the key held
+	// by the backend.
+	PublicKey() (pubKey [ed25519.PublicKeySize]byte, err error)
+	// SignOpaque signs msg using the key held by the backend and returns the
+	// raw Ed25519 signature.
+	SignOpaque(msg []byte) (sig [ed25519.SignatureSize]byte, err error)
+}
+
+// NewSignerFromOpaqueSigner adapts an OpaqueSigner to the Signer interface so
+// it can be used anywhere a Signer is accepted.
+func NewSignerFromOpaqueSigner(opaque OpaqueSigner) Signer {
+	return &opaqueSigner{opaque: opaque}
+}
+
+// opaqueSigner adapts an OpaqueSigner to Signer.
+type opaqueSigner struct {
+	opaque OpaqueSigner
+}
+
+func (o *opaqueSigner) Sign(msg []byte) (pubKey [ed25519.PublicKeySize]byte, sig [ed25519.SignatureSize]byte, err error) {
+	pubKey, err = o.opaque.PublicKey()
+	if err != nil {
+		return pubKey, sig, fmt.Errorf("unable to fetch public key from opaque signer: %w", err)
+	}
+	sig, err = o.opaque.SignOpaque(msg)
+	if err != nil {
+		return pubKey, sig, fmt.Errorf("unable to sign message with opaque signer: %w", err)
+	}
+	return pubKey, sig, nil
+}
+
+// inMemoryEd25519Signer is a Signer backed by an in-process Ed25519 private key.
+type inMemoryEd25519Signer struct {
+	priv ed25519.PrivateKey
+}
+
+// NewInMemoryEd25519Signer creates a Signer which signs directly with priv.
+// Prefer an OpaqueSigner backed Signer (see NewSignerFromOpaqueSigner) when
+// the private key must not reside in process memory.
+func NewInMemoryEd25519Signer(priv ed25519.PrivateKey) Signer {
+	return &inMemoryEd25519Signer{priv: priv}
+}
+
+func (s *inMemoryEd25519Signer) Sign(msg []byte) (pubKey [ed25519.PublicKeySize]byte, sig [ed25519.SignatureSize]byte, err error) {
+	copy(pubKey[:], s.priv.Public().(ed25519.PublicKey))
+	copy(sig[:], ed25519.Sign(s.priv, msg))
+	return pubKey, sig, nil
+}
+
+// NewEd25519SignatureFromSigner signs msg using signer and wraps the result
+// in an Ed25519Signature.
+func NewEd25519SignatureFromSigner(signer Signer, msg []byte) (*Ed25519Signature, error) {
+	pubKey, sig, err := signer.Sign(msg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign message with signer: %w", err)
+	}
+	return &Ed25519Signature{PublicKey: pubKey, Signature: sig}, nil
+}
@@ -0,0 +1,177 @@
+package iota
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	// wotsW is the WOTS+ Winternitz parameter: each message digit covers 4 bits.
+	wotsW = 16
+	// wotsN is the size in bytes of the message digest WOTS+ signs.
+	wotsN = 32
+	// wotsLen1 is the number of message digit chains (64 nibbles for a 32 byte digest).
+	wotsLen1 = 64
+	// wotsLen2 is the number of checksum digit chains.
+	wotsLen2 = 3
+	// wotsLen is the total number of hash chains (message digits plus checksum digits).
+	wotsLen = wotsLen1 + wotsLen2
+
+	// WOTSPublicSeedSize is the size in bytes of a WOTS+ public seed.
+	WOTSPublicSeedSize = 32
+	// WOTSSeedSize is the size in bytes of a WOTS+ private seed.
+	WOTSSeedSize = 32
+	// WOTSSignatureSerializedBytesSize is the size of a serialized WOTSSignature:
+	// type byte, public seed, and wotsLen chain values of wotsN bytes each.
+	WOTSSignatureSerializedBytesSize = SmallTypeDenotationByteSize + WOTSPublicSeedSize + wotsLen*wotsN
+)
+
+var (
+	// Returned when a WOTSPrivateKey is used to sign more than once.
+	ErrWOTSPrivateKeyReused = errors.New("WOTS+ private key has already been used to sign a message")
+	// Returned when a WOTS+ address and public key do not correspond to each other.
+	ErrWOTSPubKeyAndAddrMismatch = errors.New("public key and address do not correspond to each other (WOTS+)")
+)
+
+// WOTSAddress is the Blake2b-256 hash of a WOTS+ public key, analogous to
+// AddressFromEd25519PubKey.
+type WOTSAddress [32]byte
+
+// AddressFromWOTSPubKey returns the WOTSAddress for the given WOTS+ public key.
+func AddressFromWOTSPubKey(pubKey []byte) WOTSAddress {
+	return WOTSAddress(blake2b.Sum256(pubKey))
+}
+
+// WOTSPrivateKey is a Winternitz One-Time Signature Plus (WOTS+) private
+// key. As the name implies, it must only ever be used to sign a single
+// message; Sign enforces this invariant and returns ErrWOTSPrivateKeyReused
+// on reuse.
+type WOTSPrivateKey struct {
+	seed    [WOTSSeedSize]byte
+	pubSeed [WOTSPublicSeedSize]byte
+	used    bool
+}
+
+// NewWOTSPrivateKey generates a new WOTS+ private key from fresh randomness.
+func NewWOTSPrivateKey() (*WOTSPrivateKey, error) {
+	sk := &WOTSPrivateKey{}
+	if _, err := rand.Read(sk.seed[:]); err != nil {
+		return nil, fmt.Errorf("unable to generate WOTS+ seed: %w", err)
+	}
+	if _, err := rand.Read(sk.pubSeed[:]); err != nil {
+		return nil, fmt.Errorf("unable to generate WOTS+ public seed: %w", err)
+	}
+	return sk, nil
+}
+
+// PublicKey derives sk's 32 byte WOTS+ public key. It may be called any
+// number of times, including after Sign.
+func (sk *WOTSPrivateKey) PublicKey() [32]byte {
+	return wotsPublicKeyHash(wotsPublicKey(sk.seed, sk.pubSeed), sk.pubSeed)
+}
+
+// Address returns the WOTSAddress corresponding to sk's public key.
+func (sk *WOTSPrivateKey) Address() WOTSAddress {
+	pubKey := sk.PublicKey()
+	return AddressFromWOTSPubKey(pubKey[:])
+}
+
+// Sign produces a WOTS+ signature over msg. Because a WOTS+ key can only
+// safely sign a single message, Sign returns ErrWOTSPrivateKeyReused on any
+// call after the first.
+func (sk *WOTSPrivateKey) Sign(msg [wotsN]byte) (*WOTSSignature, error) {
+	if sk.used {
+		return nil, ErrWOTSPrivateKeyReused
+	}
+	digits := wotsMessageDigits(msg)
+	sig := &WOTSSignature{PubSeed: sk.pubSeed}
+	for i := 0; i < wotsLen; i++ {
+		chainSK := wotsSecretChainValue(sk.seed, uint32(i))
+		sig.Chains[i] = wotsChain(sk.pubSeed, chainSK, uint32(i), 0, int(digits[i]))
+	}
+	sk.used = true
+	return sig, nil
+}
+
+// wotsAddr encodes a chain index and iteration index into the 8 byte address
+// used by the WOTS+ chaining function F: the big-endian chain index followed
+// by the big-endian iteration index, as in the chain and hash address words
+// of an XMSS ADRS. The other ADRS words are not included.
+func wotsAddr(chainIdx, hashIdx uint32) [8]byte {
+	var addr [8]byte
+	binary.BigEndian.PutUint32(addr[0:4], chainIdx)
+	binary.BigEndian.PutUint32(addr[4:8], hashIdx)
+	return addr
+}
+
+// wotsChain iterates the WOTS+ chaining function F(x, addr) = H(pubSeed ||
+// addr || x) steps times, starting at iteration start within chain chainIdx.
+func wotsChain(pubSeed [WOTSPublicSeedSize]byte, x [wotsN]byte, chainIdx uint32, start, steps int) [wotsN]byte {
+	for i := start; i < start+steps; i++ {
+		addr := wotsAddr(chainIdx, uint32(i))
+		buf := make([]byte, 0, WOTSPublicSeedSize+len(addr)+wotsN)
+		buf = append(buf, pubSeed[:]...)
+		buf = append(buf, addr[:]...)
+		buf = append(buf, x[:]...)
+		x = blake2b.Sum256(buf)
+	}
+	return x
+}
+
+// wotsSecretChainValue derives the i-th secret chain start value (sk_i) from seed.
+func wotsSecretChainValue(seed [WOTSSeedSize]byte, i uint32) [wotsN]byte {
+	var idx [4]byte
+	binary.BigEndian.PutUint32(idx[:], i)
+	buf := make([]byte, 0, WOTSSeedSize+len(idx))
+	buf = append(buf, seed[:]...)
+	buf = append(buf, idx[:]...)
+	return blake2b.Sum256(buf)
+}
+
+// wotsPublicKey computes the wotsLen chain public key values pk_1..pk_len
+// for the given seed/pubSeed pair, each obtained by iterating the chaining
+// function wotsW-1 times on the corresponding secret chain value.
+func wotsPublicKey(seed [WOTSSeedSize]byte, pubSeed [WOTSPublicSeedSize]byte) [wotsLen][wotsN]byte {
+	var pk [wotsLen][wotsN]byte
+	for i := 0; i < wotsLen; i++ {
+		sk := wotsSecretChainValue(seed, uint32(i))
+		pk[i] = wotsChain(pubSeed, sk, uint32(i), 0, wotsW-1)
+	}
+	return pk
+}
+
+// wotsPublicKeyHash hashes the full chain public key together with pubSeed
+// into the single digest that identifies a WOTS+ public key.
+func wotsPublicKeyHash(pk [wotsLen][wotsN]byte, pubSeed [WOTSPublicSeedSize]byte) [32]byte {
+	buf := make([]byte, 0, wotsLen*wotsN+WOTSPublicSeedSize)
+	for _, chainPK := range pk {
+		buf = append(buf, chainPK[:]...)
+	}
+	buf = append(buf, pubSeed[:]...)
+	return blake2b.Sum256(buf)
+}
+
+// wotsMessageDigits splits a 32 byte message digest into 64 base-16 digits
+// (nibbles) followed by 3 base-16 checksum digits, for a total of wotsLen
+// digits, one per hash chain.
+func wotsMessageDigits(msg [wotsN]byte) [wotsLen]byte {
+	var digits [wotsLen]byte
+	for i, b := range msg {
+		digits[2*i] = b >> 4
+		digits[2*i+1] = b & 0x0f
+	}
+
+	var checksum uint32
+	for i := 0; i < wotsLen1; i++ {
+		checksum += uint32(wotsW - 1 - digits[i])
+	}
+	// checksum <= len1*(w-1) = 960, which fits in len2=3 base-16 digits (12 bits).
+	digits[wotsLen1+0] = byte((checksum >> 8) & 0x0f)
+	digits[wotsLen1+1] = byte((checksum >> 4) & 0x0f)
+	digits[wotsLen1+2] = byte(checksum & 0x0f)
+	return digits
+}